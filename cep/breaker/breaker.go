@@ -0,0 +1,100 @@
+// Package breaker implements a minimal per-provider circuit breaker so the
+// race dispatcher can stop hammering an upstream that is already failing.
+package breaker
+
+import (
+	"sync"
+	"time"
+)
+
+type state int
+
+const (
+	closed state = iota
+	open
+	halfOpen
+)
+
+// Breaker trips open after Threshold consecutive failures and stays open
+// for Cooldown before allowing a single half-open probe through. A
+// successful probe closes the breaker; a failed probe reopens it.
+type Breaker struct {
+	// Threshold is the number of consecutive failures that opens the
+	// breaker.
+	Threshold int
+	// Cooldown is how long the breaker stays open before a probe is
+	// allowed.
+	Cooldown time.Duration
+
+	mu          sync.Mutex
+	state       state
+	failures    int
+	openedAt    time.Time
+	probeActive bool
+}
+
+// New returns a closed Breaker that opens after threshold consecutive
+// failures and probes again after cooldown.
+func New(threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{Threshold: threshold, Cooldown: cooldown}
+}
+
+// Allow reports whether a request should be attempted. It returns false
+// while the breaker is open and the cooldown hasn't elapsed, or while a
+// half-open probe is already in flight.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case closed:
+		return true
+	case open:
+		if time.Since(b.openedAt) < b.Cooldown {
+			return false
+		}
+		// Cooldown elapsed: let exactly one probe through.
+		if b.probeActive {
+			return false
+		}
+		b.state = halfOpen
+		b.probeActive = true
+		return true
+	case halfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+// RecordSuccess closes the breaker and resets its failure count.
+func (b *Breaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = closed
+	b.failures = 0
+	b.probeActive = false
+}
+
+// RecordFailure registers a failed attempt, opening the breaker once
+// Threshold consecutive failures have been seen.
+func (b *Breaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probeActive = false
+
+	if b.state == halfOpen {
+		// The probe failed: reopen immediately for another cooldown.
+		b.state = open
+		b.openedAt = time.Now()
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.Threshold {
+		b.state = open
+		b.openedAt = time.Now()
+	}
+}
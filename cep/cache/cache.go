@@ -0,0 +1,25 @@
+// Package cache sits in front of the provider race, keyed by normalized
+// CEP, so repeated lookups can skip the upstream HTTP calls entirely.
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/provider"
+)
+
+// Entry is what Store keys a CEP to: either a resolved Address, or a
+// confirmed "not found" so invalid CEPs don't keep hitting upstreams.
+type Entry struct {
+	Address  provider.Address
+	NotFound bool
+}
+
+// Store caches Entry values by normalized CEP.
+type Store interface {
+	// Get returns the cached entry for cep, and ok=false on a miss.
+	Get(ctx context.Context, cep string) (entry Entry, ok bool, err error)
+	// Set caches entry for cep until ttl elapses.
+	Set(ctx context.Context, cep string, entry Entry, ttl time.Duration) error
+}
@@ -0,0 +1,23 @@
+package cache
+
+import "fmt"
+
+// Default tuning used by New when building an in-memory store.
+const defaultLRUCapacity = 10000
+
+// New builds a Store from a --cache flag value: "memory", "redis", or
+// "none". addr is the Redis address, used only when kind is "redis". New
+// returns a nil Store (not an error) for "none", meaning callers should
+// skip caching entirely.
+func New(kind, addr string) (Store, error) {
+	switch kind {
+	case "", "none":
+		return nil, nil
+	case "memory":
+		return NewLRU(defaultLRUCapacity), nil
+	case "redis":
+		return NewRedis(addr), nil
+	default:
+		return nil, fmt.Errorf("cache: unknown cache kind %q", kind)
+	}
+}
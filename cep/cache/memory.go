@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+type lruItem struct {
+	cep       string
+	entry     Entry
+	expiresAt time.Time
+}
+
+// LRU is an in-memory Store bounded by a maximum number of entries,
+// evicting the least recently used entry once full. Entries are also
+// pruned lazily once their TTL (set per-call via Set) has elapsed.
+type LRU struct {
+	capacity int
+
+	mu    sync.Mutex
+	order *list.List
+	items map[string]*list.Element
+}
+
+// NewLRU returns an LRU store holding at most capacity entries.
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements Store.
+func (c *LRU) Get(ctx context.Context, cep string) (Entry, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[cep]
+	if !ok {
+		return Entry{}, false, nil
+	}
+
+	item := el.Value.(*lruItem)
+	if time.Now().After(item.expiresAt) {
+		c.order.Remove(el)
+		delete(c.items, cep)
+		return Entry{}, false, nil
+	}
+
+	c.order.MoveToFront(el)
+	return item.entry, true, nil
+}
+
+// Set implements Store.
+func (c *LRU) Set(ctx context.Context, cep string, entry Entry, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[cep]; ok {
+		item := el.Value.(*lruItem)
+		item.entry = entry
+		item.expiresAt = time.Now().Add(ttl)
+		c.order.MoveToFront(el)
+		return nil
+	}
+
+	el := c.order.PushFront(&lruItem{cep: cep, entry: entry, expiresAt: time.Now().Add(ttl)})
+	c.items[cep] = el
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruItem).cep)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces cache keys so the CEP cache can share a Redis
+// instance with other data.
+const keyPrefix = "cepcli:cep:"
+
+// Redis is a Store backed by a Redis instance, shared across cepcli
+// replicas.
+type Redis struct {
+	Client *redis.Client
+}
+
+// NewRedis returns a Redis store talking to addr (host:port).
+func NewRedis(addr string) *Redis {
+	return &Redis{Client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+// Get implements Store.
+func (c *Redis) Get(ctx context.Context, cep string) (Entry, bool, error) {
+	data, err := c.Client.Get(ctx, keyPrefix+cep).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return Entry{}, false, nil
+	}
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("cache: redis get: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return Entry{}, false, fmt.Errorf("cache: decode entry: %w", err)
+	}
+	return entry, true, nil
+}
+
+// Set implements Store.
+func (c *Redis) Set(ctx context.Context, cep string, entry Entry, ttl time.Duration) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("cache: encode entry: %w", err)
+	}
+	if err := c.Client.Set(ctx, keyPrefix+cep, data, ttl).Err(); err != nil {
+		return fmt.Errorf("cache: redis set: %w", err)
+	}
+	return nil
+}
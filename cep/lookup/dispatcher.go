@@ -0,0 +1,89 @@
+// Package lookup wires the provider cache in front of the race dispatcher.
+package lookup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/cache"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/metrics"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/provider"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/race"
+)
+
+// Default TTLs used when Dispatcher's are left at zero.
+const (
+	DefaultTTL         = 24 * time.Hour
+	DefaultNegativeTTL = 5 * time.Minute
+)
+
+// Dispatcher checks Cache before racing Providers, and populates Cache
+// with the winning result asynchronously on a miss.
+type Dispatcher struct {
+	Providers []provider.Provider
+	Cache     cache.Store
+	// TTL is how long a resolved Address is cached. Defaults to
+	// DefaultTTL.
+	TTL time.Duration
+	// NegativeTTL is how long a confirmed "not found" is cached, to
+	// protect upstreams from repeated invalid queries. Defaults to
+	// DefaultNegativeTTL.
+	NegativeTTL time.Duration
+	Metrics     *metrics.Registry
+}
+
+// Lookup resolves cep, preferring Cache over a provider race.
+func (d *Dispatcher) Lookup(ctx context.Context, cep string) (race.Result, error) {
+	if d.Cache != nil {
+		if entry, ok, err := d.Cache.Get(ctx, cep); err == nil && ok {
+			d.recordCache(metrics.CacheHit)
+			if entry.NotFound {
+				return race.Result{}, &race.AggregateError{Errs: []error{provider.ErrNotFound}}
+			}
+			return race.Result{Address: entry.Address, Provider: "cache"}, nil
+		}
+		d.recordCache(metrics.CacheMiss)
+	}
+
+	result, err := race.First(ctx, d.Providers, cep)
+	if err != nil {
+		var agg *race.AggregateError
+		if errors.As(err, &agg) && agg.AllNotFound() {
+			d.cacheAsync(cep, cache.Entry{NotFound: true}, d.negativeTTL())
+		}
+		return race.Result{}, err
+	}
+
+	d.cacheAsync(cep, cache.Entry{Address: result.Address}, d.ttl())
+	return result, nil
+}
+
+// cacheAsync populates Cache without making the caller wait on it, per the
+// dispatcher's fire-and-forget population of the winning result.
+func (d *Dispatcher) cacheAsync(cep string, entry cache.Entry, ttl time.Duration) {
+	if d.Cache == nil {
+		return
+	}
+	go d.Cache.Set(context.Background(), cep, entry, ttl)
+}
+
+func (d *Dispatcher) recordCache(outcome string) {
+	if d.Metrics != nil {
+		d.Metrics.ObserveCache(outcome)
+	}
+}
+
+func (d *Dispatcher) ttl() time.Duration {
+	if d.TTL > 0 {
+		return d.TTL
+	}
+	return DefaultTTL
+}
+
+func (d *Dispatcher) negativeTTL() time.Duration {
+	if d.NegativeTTL > 0 {
+		return d.NegativeTTL
+	}
+	return DefaultNegativeTTL
+}
@@ -0,0 +1,143 @@
+// Package metrics tracks per-provider lookup counters and latencies and
+// renders them in the Prometheus text exposition format.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// Registry accumulates cep_lookup_total, cep_lookup_duration_seconds, and
+// cep_cache_total samples, keyed by provider/cache outcome.
+type Registry struct {
+	mu        sync.Mutex
+	totals    map[counterKey]int64
+	durations map[string]*durationSum
+	cache     map[string]int64
+}
+
+type counterKey struct {
+	provider string
+	outcome  string
+}
+
+type durationSum struct {
+	sum   float64
+	count int64
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		totals:    make(map[counterKey]int64),
+		durations: make(map[string]*durationSum),
+		cache:     make(map[string]int64),
+	}
+}
+
+// Default is the process-wide registry used by the server and CLI unless
+// a caller wires up its own.
+var Default = NewRegistry()
+
+// Outcome values recorded against cep_lookup_total.
+const (
+	OutcomeSuccess     = "success"
+	OutcomeNotFound    = "not_found"
+	OutcomeError       = "error"
+	OutcomeCircuitOpen = "circuit_open"
+)
+
+// Outcome values recorded against cep_cache_total.
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+// ObserveLookup records the outcome of a single provider lookup attempt and
+// how long it took.
+func (r *Registry) ObserveLookup(provider, outcome string, seconds float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.totals[counterKey{provider, outcome}]++
+
+	d, ok := r.durations[provider]
+	if !ok {
+		d = &durationSum{}
+		r.durations[provider] = d
+	}
+	d.sum += seconds
+	d.count++
+}
+
+// ObserveCache records a cache hit or miss.
+func (r *Registry) ObserveCache(outcome string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cache[outcome]++
+}
+
+// Handler renders the registry in the Prometheus text exposition format.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		fmt.Fprintln(w, "# HELP cep_lookup_total Total CEP lookups per provider and outcome.")
+		fmt.Fprintln(w, "# TYPE cep_lookup_total counter")
+		for _, k := range sortedCounterKeys(r.totals) {
+			fmt.Fprintf(w, "cep_lookup_total{provider=%q,outcome=%q} %d\n", k.provider, k.outcome, r.totals[k])
+		}
+
+		fmt.Fprintln(w, "# HELP cep_lookup_duration_seconds Time spent waiting on a provider lookup.")
+		fmt.Fprintln(w, "# TYPE cep_lookup_duration_seconds summary")
+		for _, p := range sortedDurationKeys(r.durations) {
+			d := r.durations[p]
+			fmt.Fprintf(w, "cep_lookup_duration_seconds_sum{provider=%q} %f\n", p, d.sum)
+			fmt.Fprintf(w, "cep_lookup_duration_seconds_count{provider=%q} %d\n", p, d.count)
+		}
+
+		fmt.Fprintln(w, "# HELP cep_cache_total Total cache lookups by outcome.")
+		fmt.Fprintln(w, "# TYPE cep_cache_total counter")
+		for _, outcome := range sortedStringKeys(r.cache) {
+			fmt.Fprintf(w, "cep_cache_total{outcome=%q} %d\n", outcome, r.cache[outcome])
+		}
+	})
+}
+
+func sortedCounterKeys(m map[counterKey]int64) []counterKey {
+	keys := make([]counterKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].provider != keys[j].provider {
+			return keys[i].provider < keys[j].provider
+		}
+		return keys[i].outcome < keys[j].outcome
+	})
+	return keys
+}
+
+func sortedDurationKeys(m map[string]*durationSum) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedStringKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// brasilAPIResponse is the response shape returned by BrasilAPI.
+type brasilAPIResponse struct {
+	Cep          string `json:"cep"`
+	State        string `json:"state"`
+	City         string `json:"city"`
+	Neighborhood string `json:"neighborhood"`
+	Street       string `json:"street"`
+	Service      string `json:"service"`
+}
+
+// defaultBrasilAPIBaseURL is used when BrasilAPI.BaseURL is empty.
+const defaultBrasilAPIBaseURL = "https://brasilapi.com.br/api/cep/v1"
+
+// BrasilAPI looks up addresses via https://brasilapi.com.br.
+type BrasilAPI struct {
+	// BaseURL overrides the default BrasilAPI endpoint, mainly for tests
+	// or alternate deployments. Defaults to defaultBrasilAPIBaseURL.
+	BaseURL string
+	// Client is the HTTP client used to issue requests. Defaults to
+	// http.DefaultClient when nil.
+	Client *http.Client
+}
+
+// Name implements Provider.
+func (p *BrasilAPI) Name() string {
+	return "brasilapi"
+}
+
+// Lookup implements Provider.
+func (p *BrasilAPI) Lookup(ctx context.Context, cep string) (Address, error) {
+	url := fmt.Sprintf("%s/%s", p.baseURL(), cep)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Address{}, err
+	}
+
+	resp, err := p.client().Do(req)
+	if err != nil {
+		return Address{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return Address{}, fmt.Errorf("%s: cep %s: %w", p.Name(), cep, ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Address{}, fmt.Errorf("%s: unexpected status %d", p.Name(), resp.StatusCode)
+	}
+
+	var body brasilAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return Address{}, fmt.Errorf("%s: decode response: %w", p.Name(), err)
+	}
+
+	return Address{
+		Cep:        body.Cep,
+		Logradouro: body.Street,
+		Bairro:     body.Neighborhood,
+		Localidade: body.City,
+		Uf:         body.State,
+	}, nil
+}
+
+func (p *BrasilAPI) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return http.DefaultClient
+}
+
+func (p *BrasilAPI) baseURL() string {
+	if p.BaseURL != "" {
+		return p.BaseURL
+	}
+	return defaultBrasilAPIBaseURL
+}
@@ -0,0 +1,35 @@
+// Package provider defines the CEP (Brazilian postal code) lookup
+// abstraction shared by every upstream implementation, as well as the
+// Address type they all produce.
+package provider
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned by Lookup (wrapped) when the upstream API
+// confirms the CEP does not exist, as opposed to a transport or decoding
+// failure.
+var ErrNotFound = errors.New("cep not found")
+
+// Address structure represents the address format used in the application.
+type Address struct {
+	Cep        string `json:"cep"`        // Postal code
+	Logradouro string `json:"logradouro"` // Street name
+	Bairro     string `json:"bairro"`     // Neighborhood
+	Localidade string `json:"localidade"` // City
+	Uf         string `json:"uf"`         // State
+}
+
+// Provider looks up an Address for a given CEP from a single upstream API.
+// Implementations must honor ctx cancellation/deadlines and return promptly
+// once ctx is done.
+type Provider interface {
+	// Name identifies the provider, e.g. for logging or reporting which
+	// upstream won a race.
+	Name() string
+	// Lookup resolves cep into an Address, or returns an error if the
+	// upstream request fails or the CEP is not found.
+	Lookup(ctx context.Context, cep string) (Address, error)
+}
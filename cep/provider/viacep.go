@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// viaCEPResponse is the response shape returned by ViaCEP.
+type viaCEPResponse struct {
+	Cep         string `json:"cep"`
+	Logradouro  string `json:"logradouro"`
+	Complemento string `json:"complemento"`
+	Bairro      string `json:"bairro"`
+	Localidade  string `json:"localidade"`
+	Uf          string `json:"uf"`
+	Ibge        string `json:"ibge"`
+	Gia         string `json:"gia"`
+	Ddd         string `json:"ddd"`
+	Siafi       string `json:"siafi"`
+	Erro        bool   `json:"erro"`
+}
+
+// defaultViaCEPBaseURL is used when ViaCEP.BaseURL is empty.
+const defaultViaCEPBaseURL = "http://viacep.com.br/ws"
+
+// ViaCEP looks up addresses via http://viacep.com.br.
+type ViaCEP struct {
+	// BaseURL overrides the default ViaCEP endpoint, mainly for tests or
+	// alternate deployments. Defaults to defaultViaCEPBaseURL.
+	BaseURL string
+	// Client is the HTTP client used to issue requests. Defaults to
+	// http.DefaultClient when nil.
+	Client *http.Client
+}
+
+// Name implements Provider.
+func (p *ViaCEP) Name() string {
+	return "viacep"
+}
+
+// Lookup implements Provider.
+func (p *ViaCEP) Lookup(ctx context.Context, cep string) (Address, error) {
+	url := fmt.Sprintf("%s/%s/json/", p.baseURL(), cep)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Address{}, err
+	}
+
+	resp, err := p.client().Do(req)
+	if err != nil {
+		return Address{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Address{}, fmt.Errorf("%s: unexpected status %d", p.Name(), resp.StatusCode)
+	}
+
+	var body viaCEPResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return Address{}, fmt.Errorf("%s: decode response: %w", p.Name(), err)
+	}
+
+	// ViaCEP reports a missing CEP as a 200 response with "erro": true
+	// rather than a non-2xx status code.
+	if body.Erro {
+		return Address{}, fmt.Errorf("%s: cep %s: %w", p.Name(), cep, ErrNotFound)
+	}
+
+	return Address{
+		Cep:        body.Cep,
+		Logradouro: body.Logradouro,
+		Bairro:     body.Bairro,
+		Localidade: body.Localidade,
+		Uf:         body.Uf,
+	}, nil
+}
+
+func (p *ViaCEP) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return http.DefaultClient
+}
+
+func (p *ViaCEP) baseURL() string {
+	if p.BaseURL != "" {
+		return p.BaseURL
+	}
+	return defaultViaCEPBaseURL
+}
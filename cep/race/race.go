@@ -0,0 +1,89 @@
+// Package race dispatches a CEP lookup to every configured provider
+// concurrently and returns whichever answers first.
+package race
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/provider"
+)
+
+// Result is the outcome of a race: the winning Address and the name of the
+// provider that produced it.
+type Result struct {
+	Address  provider.Address
+	Provider string
+}
+
+// AggregateError is returned by First when every provider fails. It keeps
+// the individual errors around so callers can tell a confirmed "not found"
+// apart from transport/decoding failures.
+type AggregateError struct {
+	Errs []error
+}
+
+func (e *AggregateError) Error() string {
+	return fmt.Sprintf("race: all providers failed: %v", errors.Join(e.Errs...))
+}
+
+func (e *AggregateError) Unwrap() []error {
+	return e.Errs
+}
+
+// AllNotFound reports whether every provider failed because the CEP is
+// confirmed not to exist, as opposed to a transport, timeout, or decoding
+// error.
+func (e *AggregateError) AllNotFound() bool {
+	for _, err := range e.Errs {
+		if !errors.Is(err, provider.ErrNotFound) {
+			return false
+		}
+	}
+	return true
+}
+
+// First spins up one goroutine per provider and returns the first
+// successful Address along with the name of the provider that won. The
+// remaining goroutines are canceled via ctx once a winner is found. If every
+// provider fails, First returns an error aggregating all of their failures.
+func First(ctx context.Context, providers []provider.Provider, cep string) (Result, error) {
+	if len(providers) == 0 {
+		return Result{}, errors.New("race: no providers configured")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type outcome struct {
+		result Result
+		err    error
+	}
+
+	outcomes := make(chan outcome, len(providers))
+
+	for _, p := range providers {
+		p := p
+		go func() {
+			addr, err := p.Lookup(ctx, cep)
+			if err != nil {
+				outcomes <- outcome{err: fmt.Errorf("%s: %w", p.Name(), err)}
+				return
+			}
+			outcomes <- outcome{result: Result{Address: addr, Provider: p.Name()}}
+		}()
+	}
+
+	var errs []error
+	for i := 0; i < len(providers); i++ {
+		o := <-outcomes
+		if o.err != nil {
+			errs = append(errs, o.err)
+			continue
+		}
+		return o.result, nil
+	}
+
+	return Result{}, &AggregateError{Errs: errs}
+}
@@ -0,0 +1,157 @@
+// Package registry builds the set of resilient providers the race
+// dispatcher runs against, driven by a JSON config file (given directly or
+// named by the CEPCLI_CONFIG environment variable) instead of a hardcoded
+// provider list. Provider settings are read only from that file.
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/breaker"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/metrics"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/provider"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/resilient"
+)
+
+// ConfigEnvVar names the environment variable holding the path to a JSON
+// config file, consulted by LoadConfig when no explicit path is given.
+const ConfigEnvVar = "CEPCLI_CONFIG"
+
+// Duration wraps time.Duration so config files can use strings like "1s"
+// or "500ms" instead of raw nanoseconds.
+type Duration time.Duration
+
+// UnmarshalJSON accepts either a duration string ("1s") or a plain number
+// of nanoseconds.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case string:
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("registry: invalid duration %q: %w", v, err)
+		}
+		*d = Duration(parsed)
+	case float64:
+		*d = Duration(time.Duration(v))
+	default:
+		return fmt.Errorf("registry: invalid duration value %v", raw)
+	}
+	return nil
+}
+
+// ProviderConfig describes one upstream CEP API and how resiliently to
+// call it.
+type ProviderConfig struct {
+	// Name selects the provider implementation: "brasilapi" or "viacep".
+	Name string `json:"name"`
+	// BaseURL overrides the provider's default endpoint.
+	BaseURL string `json:"base_url"`
+	// Timeout bounds a single attempt. Defaults to 1s.
+	Timeout Duration `json:"timeout"`
+	// Retries is the number of retries after a transient failure.
+	// Defaults to 0 (no retries).
+	Retries int `json:"retries"`
+	// Backoff is the base retry delay, doubled each attempt with jitter.
+	// Defaults to 100ms.
+	Backoff Duration `json:"backoff"`
+	// BreakerThreshold is the number of consecutive failures that opens
+	// the circuit breaker. Defaults to 5.
+	BreakerThreshold int `json:"breaker_threshold"`
+	// BreakerCooldown is how long the breaker stays open before probing
+	// again. Defaults to 30s.
+	BreakerCooldown Duration `json:"breaker_cooldown"`
+}
+
+// Config is the top-level registry configuration.
+type Config struct {
+	Providers []ProviderConfig `json:"providers"`
+}
+
+// Default returns the built-in two-provider configuration used when no
+// config file is supplied.
+func Default() Config {
+	return Config{
+		Providers: []ProviderConfig{
+			{Name: "brasilapi"},
+			{Name: "viacep"},
+		},
+	}
+}
+
+// LoadConfig reads a JSON config file from path. If path is empty, it
+// falls back to the CEPCLI_CONFIG environment variable, and to Default()
+// if that is unset too.
+func LoadConfig(path string) (Config, error) {
+	if path == "" {
+		path = os.Getenv(ConfigEnvVar)
+	}
+	if path == "" {
+		return Default(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("registry: read config: %w", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("registry: parse config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Build turns cfg into the resilient providers the race dispatcher runs
+// against, recording metrics to reg.
+func Build(cfg Config, reg *metrics.Registry) ([]provider.Provider, error) {
+	providers := make([]provider.Provider, 0, len(cfg.Providers))
+	for _, pc := range cfg.Providers {
+		upstream, err := newUpstream(pc)
+		if err != nil {
+			return nil, err
+		}
+
+		providers = append(providers, &resilient.Provider{
+			Upstream: upstream,
+			Timeout:  withDefault(time.Duration(pc.Timeout), 1*time.Second),
+			Retries:  pc.Retries,
+			Backoff:  withDefault(time.Duration(pc.Backoff), 100*time.Millisecond),
+			Breaker:  breaker.New(withDefaultInt(pc.BreakerThreshold, 5), withDefault(time.Duration(pc.BreakerCooldown), 30*time.Second)),
+			Metrics:  reg,
+		})
+	}
+	return providers, nil
+}
+
+func newUpstream(pc ProviderConfig) (provider.Provider, error) {
+	switch pc.Name {
+	case "brasilapi":
+		return &provider.BrasilAPI{BaseURL: pc.BaseURL}, nil
+	case "viacep":
+		return &provider.ViaCEP{BaseURL: pc.BaseURL}, nil
+	default:
+		return nil, fmt.Errorf("registry: unknown provider %q", pc.Name)
+	}
+}
+
+func withDefault(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
+
+func withDefaultInt(n, def int) int {
+	if n > 0 {
+		return n
+	}
+	return def
+}
@@ -0,0 +1,120 @@
+// Package resilient wraps a provider.Provider with per-call timeouts,
+// retries with exponential backoff and jitter, circuit breaking, and
+// metrics, so the race dispatcher can treat a flaky upstream like any
+// other Provider.
+package resilient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/breaker"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/metrics"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/provider"
+)
+
+// ErrCircuitOpen is returned when the breaker is open and the call is
+// short-circuited without hitting the upstream.
+var ErrCircuitOpen = errors.New("resilient: circuit open")
+
+// Provider decorates a provider.Provider with timeout, retry, circuit
+// breaking, and metrics behavior.
+type Provider struct {
+	Upstream provider.Provider
+
+	// Timeout bounds a single attempt against Upstream.
+	Timeout time.Duration
+	// Retries is the number of additional attempts after the first one
+	// fails with a transient (non-not-found) error.
+	Retries int
+	// Backoff is the base delay before the first retry; each subsequent
+	// retry doubles it, plus up to 20% jitter.
+	Backoff time.Duration
+
+	Breaker *breaker.Breaker
+	Metrics *metrics.Registry
+}
+
+// Name implements provider.Provider.
+func (p *Provider) Name() string {
+	return p.Upstream.Name()
+}
+
+// Lookup implements provider.Provider, retrying transient failures and
+// short-circuiting while the breaker is open.
+func (p *Provider) Lookup(ctx context.Context, cep string) (provider.Address, error) {
+	if p.Breaker != nil && !p.Breaker.Allow() {
+		p.record(0, metrics.OutcomeCircuitOpen)
+		return provider.Address{}, fmt.Errorf("%s: %w", p.Name(), ErrCircuitOpen)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= p.Retries; attempt++ {
+		if attempt > 0 {
+			if err := p.sleep(ctx, attempt); err != nil {
+				return provider.Address{}, err
+			}
+		}
+
+		start := time.Now()
+		attemptCtx, cancel := context.WithTimeout(ctx, p.Timeout)
+		addr, err := p.Upstream.Lookup(attemptCtx, cep)
+		cancel()
+		elapsed := time.Since(start).Seconds()
+
+		if err == nil {
+			p.record(elapsed, metrics.OutcomeSuccess)
+			p.recordBreaker(true)
+			return addr, nil
+		}
+
+		lastErr = err
+
+		if errors.Is(err, provider.ErrNotFound) {
+			// The upstream is healthy; it just doesn't have this CEP.
+			// Don't retry and don't count it against the breaker.
+			p.record(elapsed, metrics.OutcomeNotFound)
+			p.recordBreaker(true)
+			return provider.Address{}, err
+		}
+
+		p.record(elapsed, metrics.OutcomeError)
+		p.recordBreaker(false)
+	}
+
+	return provider.Address{}, lastErr
+}
+
+func (p *Provider) sleep(ctx context.Context, attempt int) error {
+	delay := p.Backoff << (attempt - 1)
+	jitter := time.Duration(rand.Int63n(int64(delay)/5 + 1))
+	timer := time.NewTimer(delay + jitter)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (p *Provider) record(seconds float64, outcome string) {
+	if p.Metrics != nil {
+		p.Metrics.ObserveLookup(p.Name(), outcome, seconds)
+	}
+}
+
+func (p *Provider) recordBreaker(success bool) {
+	if p.Breaker == nil {
+		return
+	}
+	if success {
+		p.Breaker.RecordSuccess()
+	} else {
+		p.Breaker.RecordFailure()
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+)
+
+// accessLogEntry is the structured line written per request.
+type accessLogEntry struct {
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	Status   int    `json:"status"`
+	Duration string `json:"duration"`
+}
+
+// statusRecorder captures the status code written by the wrapped handler,
+// since http.ResponseWriter doesn't expose it after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// withAccessLog wraps h, emitting one JSON log line per request to stdout.
+func withAccessLog(h http.Handler) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/", logMiddleware(h))
+	return mux
+}
+
+func logMiddleware(next http.Handler) http.Handler {
+	encoder := json.NewEncoder(os.Stdout)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rec, r)
+
+		_ = encoder.Encode(accessLogEntry{
+			Method:   r.Method,
+			Path:     r.URL.Path,
+			Status:   rec.status,
+			Duration: time.Since(start).String(),
+		})
+	})
+}
@@ -0,0 +1,112 @@
+// Package server exposes the CEP provider race over HTTP.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/lookup"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/metrics"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/race"
+)
+
+var cepPattern = regexp.MustCompile(`^\d{8}$`)
+
+// Server handles HTTP requests for CEP lookups, dispatching each one
+// through Dispatcher (cache, then provider race).
+type Server struct {
+	Dispatcher *lookup.Dispatcher
+	// Timeout bounds how long a single /cep/{cep} request may take before
+	// responding 504. Defaults to 1 second when zero.
+	Timeout time.Duration
+	// Metrics is served at /metrics in the Prometheus text format.
+	// Defaults to metrics.Default when nil.
+	Metrics *metrics.Registry
+}
+
+// NewMux builds the HTTP routes served by Server.
+func (s *Server) NewMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", s.handleHealthz)
+	mux.HandleFunc("GET /cep/{cep}", s.handleLookup)
+	mux.Handle("GET /metrics", s.metrics().Handler())
+	return withAccessLog(mux)
+}
+
+func (s *Server) metrics() *metrics.Registry {
+	if s.Metrics != nil {
+		return s.Metrics
+	}
+	return metrics.Default
+}
+
+func (s *Server) timeout() time.Duration {
+	if s.Timeout > 0 {
+		return s.Timeout
+	}
+	return 1 * time.Second
+}
+
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Server) handleLookup(w http.ResponseWriter, r *http.Request) {
+	cep := normalizeCep(r.PathValue("cep"))
+	if !cepPattern.MatchString(cep) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "cep must be 8 digits"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout())
+	defer cancel()
+
+	result, err := s.Dispatcher.Lookup(ctx, cep)
+	if err != nil {
+		s.writeError(w, ctx, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"cep":        result.Address.Cep,
+		"logradouro": result.Address.Logradouro,
+		"bairro":     result.Address.Bairro,
+		"localidade": result.Address.Localidade,
+		"uf":         result.Address.Uf,
+		"provider":   result.Provider,
+	})
+}
+
+func (s *Server) writeError(w http.ResponseWriter, ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		writeJSON(w, http.StatusGatewayTimeout, map[string]string{"error": "timed out waiting for providers"})
+		return
+	}
+
+	var agg *race.AggregateError
+	if errors.As(err, &agg) && agg.AllNotFound() {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "cep not found"})
+		return
+	}
+
+	writeJSON(w, http.StatusBadGateway, map[string]string{"error": "all providers failed"})
+}
+
+// normalizeCep strips dashes so callers may pass "01153-000" or "01153000".
+func normalizeCep(cep string) string {
+	return strings.ReplaceAll(cep, "-", "")
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("server: write response: %v", err)
+	}
+}
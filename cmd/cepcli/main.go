@@ -0,0 +1,108 @@
+// Command cepcli looks up a Brazilian postal code (CEP) by racing multiple
+// upstream APIs and printing the address returned by whichever responds
+// first. It can also run as an HTTP microservice via the "serve" subcommand.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/cache"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/lookup"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/metrics"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/registry"
+	"github.com/Gustavo-Villar/go-multithreading-api-calls/cep/server"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		runServe(os.Args[2:])
+		return
+	}
+	runLookup(os.Args[1:])
+}
+
+func runLookup(args []string) {
+	fs := flag.NewFlagSet("cepcli", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a JSON provider config file (default: $CEPCLI_CONFIG or the built-in providers)")
+	cacheKind := fs.String("cache", "none", "response cache: memory, redis, or none")
+	redisAddr := fs.String("redis-addr", "localhost:6379", "redis address, used when -cache=redis")
+	fs.Parse(args)
+
+	// Set a default postal code (CEP)
+	cep := "01153000"
+
+	// If a postal code is provided as a command-line argument, use that instead
+	if rest := fs.Args(); len(rest) > 0 {
+		cep = rest[0]
+	}
+
+	cfg, err := registry.LoadConfig(*configPath)
+	if err != nil {
+		fmt.Printf("Erro: %v\n", err)
+		return
+	}
+	providers, err := registry.Build(cfg, metrics.Default)
+	if err != nil {
+		fmt.Printf("Erro: %v\n", err)
+		return
+	}
+	store, err := cache.New(*cacheKind, *redisAddr)
+	if err != nil {
+		fmt.Printf("Erro: %v\n", err)
+		return
+	}
+	dispatcher := &lookup.Dispatcher{Providers: providers, Cache: store, Metrics: metrics.Default}
+
+	// Create a context with a 1-second timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	result, err := dispatcher.Lookup(ctx, cep)
+	if err != nil {
+		if ctx.Err() != nil {
+			fmt.Println("Timeout: Nenhuma das APIs respondeu em tempo hábil.")
+			return
+		}
+		fmt.Printf("Erro: %v\n", err)
+		return
+	}
+
+	addr := result.Address
+	fmt.Printf("Endereço (%s): %s, %s, %s - %s\n", result.Provider, addr.Logradouro, addr.Bairro, addr.Localidade, addr.Uf)
+}
+
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	configPath := fs.String("config", "", "path to a JSON provider config file (default: $CEPCLI_CONFIG or the built-in providers)")
+	cacheKind := fs.String("cache", "none", "response cache: memory, redis, or none")
+	redisAddr := fs.String("redis-addr", "localhost:6379", "redis address, used when -cache=redis")
+	fs.Parse(args)
+
+	cfg, err := registry.LoadConfig(*configPath)
+	if err != nil {
+		log.Fatalf("cepcli: %v", err)
+	}
+	providers, err := registry.Build(cfg, metrics.Default)
+	if err != nil {
+		log.Fatalf("cepcli: %v", err)
+	}
+	store, err := cache.New(*cacheKind, *redisAddr)
+	if err != nil {
+		log.Fatalf("cepcli: %v", err)
+	}
+	dispatcher := &lookup.Dispatcher{Providers: providers, Cache: store, Metrics: metrics.Default}
+
+	srv := &server.Server{Dispatcher: dispatcher}
+
+	log.Printf("cepcli: listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, srv.NewMux()); err != nil {
+		log.Fatalf("cepcli: serve: %v", err)
+	}
+}